@@ -0,0 +1,123 @@
+package timeseriesinsights
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmTimeSeriesInsightsEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmTimeSeriesInsightsEnvironmentRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// storage_limited_exceeded_behavior and data_retention_time only apply to Standard (Gen1)
+			// Environments - they're left unset when `name` refers to a Long-Term (Gen2) Environment
+			"storage_limited_exceeded_behavior": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"data_retention_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"data_access_fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceArmTimeSeriesInsightsEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.EnvironmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil || resp.Value == nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Time Series Insights Environment %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("retrieving Time Series Insights Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if environment, ok := resp.Value.AsStandardEnvironmentResource(); ok {
+		if environment.ID == nil {
+			return fmt.Errorf("cannot read Time Series Insights Environment %q (Resource Group %q) ID", name, resourceGroup)
+		}
+
+		d.SetId(*environment.ID)
+
+		d.Set("name", environment.Name)
+		d.Set("resource_group_name", resourceGroup)
+		d.Set("sku_name", flattenEnvironmentSkuName(environment.Sku))
+		if location := environment.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
+		}
+
+		if props := environment.StandardEnvironmentResourceProperties; props != nil {
+			d.Set("storage_limited_exceeded_behavior", string(props.StorageLimitExceededBehavior))
+			d.Set("data_retention_time", props.DataRetentionTime)
+			d.Set("data_access_fqdn", props.DataAccessFqdn)
+		}
+
+		return tags.FlattenAndSet(d, environment.Tags)
+	}
+
+	environment, ok := resp.Value.AsLongTermEnvironmentResource()
+	if !ok {
+		return fmt.Errorf("the Time Series Insights Environment %q (Resource Group %q) is neither a Standard (Gen1) nor a Long-Term (Gen2) Environment", name, resourceGroup)
+	}
+
+	if environment.ID == nil {
+		return fmt.Errorf("cannot read Time Series Insights Environment %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*environment.ID)
+
+	d.Set("name", environment.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("sku_name", flattenEnvironmentSkuName(environment.Sku))
+	if location := environment.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := environment.LongTermEnvironmentResourceProperties; props != nil {
+		d.Set("data_access_fqdn", props.DataAccessFqdn)
+	}
+
+	return tags.FlattenAndSet(d, environment.Tags)
+}
@@ -0,0 +1,117 @@
+package timeseriesinsights_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccDataSourceAzureRMTimeSeriesInsightsEnvironment_standard(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_time_series_insights_environment", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMTimeSeriesInsightsEnvironment_standardConfig(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "sku_name", "S1_1"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "data_retention_time"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "data_access_fqdn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMTimeSeriesInsightsEnvironment_gen2(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_time_series_insights_environment", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMTimeSeriesInsightsEnvironment_gen2Config(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "sku_name", "L1_1"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "data_access_fqdn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMTimeSeriesInsightsEnvironment_standardConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-tsi-%d"
+  location = "%s"
+}
+
+resource "azurerm_time_series_insights_environment" "test" {
+  name                = "acctestTSIEnv%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "S1_1"
+  data_retention_time = "P30D"
+}
+
+data "azurerm_time_series_insights_environment" "test" {
+  name                = azurerm_time_series_insights_environment.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func testAccDataSourceAzureRMTimeSeriesInsightsEnvironment_gen2Config(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-tsi-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsatsi%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_time_series_insights_gen2_environment" "test" {
+  name                = "acctestTSIGen2Env%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "L1_1"
+
+  storage_configuration {
+    storage_account_name = azurerm_storage_account.test.name
+    management_key       = azurerm_storage_account.test.primary_access_key
+  }
+
+  time_series_id_properties {
+    name = "id"
+    type = "String"
+  }
+}
+
+data "azurerm_time_series_insights_environment" "test" {
+  name                = azurerm_time_series_insights_gen2_environment.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
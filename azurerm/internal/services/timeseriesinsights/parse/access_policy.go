@@ -0,0 +1,40 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type AccessPolicyId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	EnvironmentName string
+	Name            string
+}
+
+func AccessPolicyID(input string) (*AccessPolicyId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Time Series Insights Access Policy ID %q: %+v", input, err)
+	}
+
+	policy := AccessPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if policy.EnvironmentName, err = id.PopSegment("environments"); err != nil {
+		return nil, err
+	}
+
+	if policy.Name, err = id.PopSegment("accessPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
@@ -0,0 +1,65 @@
+package parse
+
+import "testing"
+
+func TestAccessPolicyID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *AccessPolicyId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/accessPolicies",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/accessPolicies/policy1",
+			Expected: &AccessPolicyId{
+				SubscriptionId:  "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:   "resGroup1",
+				EnvironmentName: "environment1",
+				Name:            "policy1",
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("Testing %q..", test.Input)
+
+		actual, err := AccessPolicyID(test.Input)
+		if err != nil {
+			if test.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if test.Expected == nil {
+			t.Fatalf("Expected an error but didn't get one for %q", test.Input)
+		}
+
+		if actual.SubscriptionId != test.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", test.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.ResourceGroup != test.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", test.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.EnvironmentName != test.Expected.EnvironmentName {
+			t.Fatalf("Expected %q but got %q for EnvironmentName", test.Expected.EnvironmentName, actual.EnvironmentName)
+		}
+
+		if actual.Name != test.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", test.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type EnvironmentId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+// ID returns the Azure Resource Manager ID for this Environment
+func (id EnvironmentId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.TimeSeriesInsights/environments/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// TimeSeriesInsightsEnvironmentID parses an Environment ID. The same ID shape
+// is shared by both the Standard (Gen1) and Long-Term (Gen2) environment
+// kinds - callers must dispatch on the resource returned from the API to
+// determine which kind they're looking at.
+func TimeSeriesInsightsEnvironmentID(input string) (*EnvironmentId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Time Series Insights Environment ID %q: %+v", input, err)
+	}
+
+	environment := EnvironmentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if environment.Name, err = id.PopSegment("environments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &environment, nil
+}
@@ -0,0 +1,90 @@
+package parse
+
+import "testing"
+
+func TestTimeSeriesInsightsEnvironmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *EnvironmentId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments",
+			Expected: nil,
+		},
+		{
+			// wrong case for the resource type is rejected
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/Environments/environment1",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1",
+			Expected: &EnvironmentId{
+				SubscriptionId: "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:  "resGroup1",
+				Name:           "environment1",
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("Testing %q..", test.Input)
+
+		actual, err := TimeSeriesInsightsEnvironmentID(test.Input)
+		if err != nil {
+			if test.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if test.Expected == nil {
+			t.Fatalf("Expected an error but didn't get one for %q", test.Input)
+		}
+
+		if actual.SubscriptionId != test.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", test.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.ResourceGroup != test.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", test.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.Name != test.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", test.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestTimeSeriesInsightsEnvironmentID_roundTrip(t *testing.T) {
+	id := EnvironmentId{
+		SubscriptionId: "00000000-0000-0000-0000-000000000000",
+		ResourceGroup:  "resGroup1",
+		Name:           "environment1",
+	}
+
+	actual, err := TimeSeriesInsightsEnvironmentID(id.ID())
+	if err != nil {
+		t.Fatalf("failed to re-parse generated ID %q: %s", id.ID(), err)
+	}
+
+	if *actual != id {
+		t.Fatalf("Expected %+v but got %+v", id, *actual)
+	}
+}
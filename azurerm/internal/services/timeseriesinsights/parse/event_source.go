@@ -0,0 +1,40 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type EventSourceId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	EnvironmentName string
+	Name            string
+}
+
+func EventSourceID(input string) (*EventSourceId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Time Series Insights Event Source ID %q: %+v", input, err)
+	}
+
+	eventSource := EventSourceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if eventSource.EnvironmentName, err = id.PopSegment("environments"); err != nil {
+		return nil, err
+	}
+
+	if eventSource.Name, err = id.PopSegment("eventSources"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &eventSource, nil
+}
@@ -0,0 +1,40 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ReferenceDataSetId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	EnvironmentName string
+	Name            string
+}
+
+func ReferenceDataSetID(input string) (*ReferenceDataSetId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Time Series Insights Reference Data Set ID %q: %+v", input, err)
+	}
+
+	dataSet := ReferenceDataSetId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if dataSet.EnvironmentName, err = id.PopSegment("environments"); err != nil {
+		return nil, err
+	}
+
+	if dataSet.Name, err = id.PopSegment("referenceDataSets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &dataSet, nil
+}
@@ -0,0 +1,65 @@
+package parse
+
+import "testing"
+
+func TestReferenceDataSetID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *ReferenceDataSetId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/referenceDataSets",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/referenceDataSets/dataset1",
+			Expected: &ReferenceDataSetId{
+				SubscriptionId:  "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:   "resGroup1",
+				EnvironmentName: "environment1",
+				Name:            "dataset1",
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("Testing %q..", test.Input)
+
+		actual, err := ReferenceDataSetID(test.Input)
+		if err != nil {
+			if test.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if test.Expected == nil {
+			t.Fatalf("Expected an error but didn't get one for %q", test.Input)
+		}
+
+		if actual.SubscriptionId != test.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", test.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.ResourceGroup != test.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", test.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.EnvironmentName != test.Expected.EnvironmentName {
+			t.Fatalf("Expected %q but got %q for EnvironmentName", test.Expected.EnvironmentName, actual.EnvironmentName)
+		}
+
+		if actual.Name != test.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", test.Expected.Name, actual.Name)
+		}
+	}
+}
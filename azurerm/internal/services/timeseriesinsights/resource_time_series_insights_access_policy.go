@@ -0,0 +1,209 @@
+package timeseriesinsights
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/timeseriesinsights/mgmt/2018-08-15-preview/timeseriesinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/timeseriesinsights/parse"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmTimeSeriesInsightsAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmTimeSeriesInsightsAccessPolicyCreateUpdate,
+		Read:   resourceArmTimeSeriesInsightsAccessPolicyRead,
+		Update: resourceArmTimeSeriesInsightsAccessPolicyCreateUpdate,
+		Delete: resourceArmTimeSeriesInsightsAccessPolicyDelete,
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.AccessPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[-\w\._\(\)]+$`),
+					"Time Series Insights Access Policy name must not be empty and may contain only word characters, hyphens, periods and parentheses.",
+				),
+			},
+
+			"time_series_insights_environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"principal_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"roles": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(timeseriesinsights.Reader),
+						string(timeseriesinsights.Contributor),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceArmTimeSeriesInsightsAccessPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.AccessPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	environmentId, err := parse.TimeSeriesInsightsEnvironmentID(d.Get("time_series_insights_environment_id").(string))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, environmentId.ResourceGroup, environmentId.Name, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Time Series Insights Access Policy %q (Environment %q / Resource Group %q): %s", name, environmentId.Name, environmentId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_time_series_insights_access_policy", *existing.ID)
+		}
+	}
+
+	policy := timeseriesinsights.AccessPolicyCreateOrUpdateParameters{
+		AccessPolicyResourceProperties: &timeseriesinsights.AccessPolicyResourceProperties{
+			PrincipalObjectID: utils.String(d.Get("principal_object_id").(string)),
+			Description:       utils.String(d.Get("description").(string)),
+			Roles:             expandTimeSeriesInsightsAccessPolicyRoles(d.Get("roles").(*schema.Set).List()),
+		},
+	}
+
+	resp, err := client.CreateOrUpdate(ctx, environmentId.ResourceGroup, environmentId.Name, name, policy)
+	if err != nil {
+		return fmt.Errorf("creating/updating Time Series Insights Access Policy %q (Environment %q / Resource Group %q): %+v", name, environmentId.Name, environmentId.ResourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("cannot read Time Series Insights Access Policy %q (Environment %q / Resource Group %q) ID", name, environmentId.Name, environmentId.ResourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmTimeSeriesInsightsAccessPolicyRead(d, meta)
+}
+
+func resourceArmTimeSeriesInsightsAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.AccessPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AccessPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Time Series Insights Access Policy %q (Environment %q / Resource Group %q): %+v", id.Name, id.EnvironmentName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	environmentId := parse.EnvironmentId{SubscriptionId: id.SubscriptionId, ResourceGroup: id.ResourceGroup, Name: id.EnvironmentName}
+	d.Set("time_series_insights_environment_id", environmentId.ID())
+
+	if props := resp.AccessPolicyResourceProperties; props != nil {
+		d.Set("principal_object_id", props.PrincipalObjectID)
+		d.Set("description", props.Description)
+
+		if err := d.Set("roles", flattenTimeSeriesInsightsAccessPolicyRoles(props.Roles)); err != nil {
+			return fmt.Errorf("setting `roles`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmTimeSeriesInsightsAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.AccessPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AccessPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Delete(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("deleting Time Series Insights Access Policy %q (Environment %q / Resource Group %q): %+v", id.Name, id.EnvironmentName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandTimeSeriesInsightsAccessPolicyRoles(input []interface{}) *[]timeseriesinsights.AccessPolicyRole {
+	results := make([]timeseriesinsights.AccessPolicyRole, 0)
+
+	for _, item := range input {
+		results = append(results, timeseriesinsights.AccessPolicyRole(item.(string)))
+	}
+
+	return &results
+}
+
+func flattenTimeSeriesInsightsAccessPolicyRoles(input *[]timeseriesinsights.AccessPolicyRole) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, string(item))
+	}
+
+	return results
+}
@@ -33,6 +33,8 @@ func resourceArmTimeSeriesInsightsEnvironment() *schema.Resource {
 			return err
 		}),
 
+		CustomizeDiff: timeSeriesInsightsEnvironmentCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -99,6 +101,11 @@ func resourceArmTimeSeriesInsightsEnvironment() *schema.Resource {
 				ValidateFunc: azValidate.ISO8601Duration,
 			},
 
+			"data_access_fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.ForceNewSchema(),
 		},
 	}
@@ -118,7 +125,33 @@ func resourceArmTimeSeriesInsightsEnvironmentCreateUpdate(d *schema.ResourceData
 		return fmt.Errorf("expanding sku: %+v", err)
 	}
 
-	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+	if !d.IsNewResource() {
+		id, err := parse.TimeSeriesInsightsEnvironmentID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		update := timeseriesinsights.StandardEnvironmentUpdateParameters{
+			Tags: tags.Expand(t),
+			Sku:  sku,
+			StandardEnvironmentMutableProperties: &timeseriesinsights.StandardEnvironmentMutableProperties{
+				StorageLimitExceededBehavior: timeseriesinsights.StorageLimitExceededBehavior(d.Get("storage_limited_exceeded_behavior").(string)),
+			},
+		}
+
+		future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
+		if err != nil {
+			return fmt.Errorf("updating Time Series Insights Environment %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of Time Series Insights Environment %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		return resourceArmTimeSeriesInsightsEnvironmentRead(d, meta)
+	}
+
+	if features.ShouldResourcesBeImported() {
 		existing, err := client.Get(ctx, resourceGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -213,6 +246,7 @@ func resourceArmTimeSeriesInsightsEnvironmentRead(d *schema.ResourceData, meta i
 	if props := environment.StandardEnvironmentResourceProperties; props != nil {
 		d.Set("storage_limited_exceeded_behavior", string(props.StorageLimitExceededBehavior))
 		d.Set("data_retention_time", props.DataRetentionTime)
+		d.Set("data_access_fqdn", props.DataAccessFqdn)
 	}
 
 	return tags.FlattenAndSet(d, environment.Tags)
@@ -238,6 +272,24 @@ func resourceArmTimeSeriesInsightsEnvironmentDelete(d *schema.ResourceData, meta
 	return nil
 }
 
+func timeSeriesInsightsEnvironmentCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("sku_name") {
+		oldRaw, newRaw := d.GetChange("sku_name")
+		oldTier := strings.Split(oldRaw.(string), "_")[0]
+		newTier := strings.Split(newRaw.(string), "_")[0]
+
+		// scaling capacity within the same SKU tier can be done in-place, but switching between
+		// tiers (e.g. S1 <-> S2) requires the Environment to be recreated
+		if oldTier != newTier {
+			if err := d.ForceNew("sku_name"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandEnvironmentSkuName(skuName string) (*timeseriesinsights.Sku, error) {
 	parts := strings.Split(skuName, "_")
 	if len(parts) != 2 {
@@ -250,6 +302,8 @@ func expandEnvironmentSkuName(skuName string) (*timeseriesinsights.Sku, error) {
 		name = timeseriesinsights.S1
 	case "S2":
 		name = timeseriesinsights.S2
+	case "L1":
+		name = timeseriesinsights.L1
 	default:
 		return nil, fmt.Errorf("sku_name %s has unknown sku tier %s", skuName, parts[0])
 	}
@@ -271,4 +325,4 @@ func flattenEnvironmentSkuName(input *timeseriesinsights.Sku) string {
 	}
 
 	return fmt.Sprintf("%s_%d", string(input.Name), *input.Capacity)
-}
\ No newline at end of file
+}
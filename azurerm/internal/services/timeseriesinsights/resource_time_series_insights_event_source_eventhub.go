@@ -0,0 +1,230 @@
+package timeseriesinsights
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/timeseriesinsights/mgmt/2018-08-15-preview/timeseriesinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/timeseriesinsights/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmTimeSeriesInsightsEventSourceEventHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmTimeSeriesInsightsEventSourceEventHubCreateUpdate,
+		Read:   resourceArmTimeSeriesInsightsEventSourceEventHubRead,
+		Update: resourceArmTimeSeriesInsightsEventSourceEventHubCreateUpdate,
+		Delete: resourceArmTimeSeriesInsightsEventSourceEventHubDelete,
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.EventSourceID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[-\w\._\(\)]+$`),
+					"Time Series Insights Event Source name must not be empty and may contain only word characters, hyphens, periods and parentheses.",
+				),
+			},
+
+			"time_series_insights_environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"event_source_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"consumer_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"shared_access_key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"shared_access_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"timestamp_property_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"tags": tags.ForceNewSchema(),
+		},
+	}
+}
+
+func resourceArmTimeSeriesInsightsEventSourceEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.EventSourcesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	environmentId, err := parse.TimeSeriesInsightsEnvironmentID(d.Get("time_series_insights_environment_id").(string))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, environmentId.ResourceGroup, environmentId.Name, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Time Series Insights Event Hub Event Source %q (Environment %q / Resource Group %q): %s", name, environmentId.Name, environmentId.ResourceGroup, err)
+			}
+		}
+
+		if existing.Value != nil {
+			eventSource, ok := existing.Value.AsEventHubEventSourceResource()
+			if !ok {
+				return fmt.Errorf("exisiting resource was not an Event Hub Time Series Insights Event Source %q (Environment %q / Resource Group %q)", name, environmentId.Name, environmentId.ResourceGroup)
+			}
+
+			if eventSource.ID != nil && *eventSource.ID != "" {
+				return tf.ImportAsExistsError("azurerm_time_series_insights_event_source_eventhub", *eventSource.ID)
+			}
+		}
+	}
+
+	eventSourceProperties := timeseriesinsights.EventHubEventSourceCreateOrUpdateParameters{
+		Location: &location,
+		Tags:     tags.Expand(t),
+		EventHubEventSourceCreationProperties: &timeseriesinsights.EventHubEventSourceCreationProperties{
+			EventSourceResourceID: utils.String(d.Get("event_source_resource_id").(string)),
+			ConsumerGroupName:     utils.String(d.Get("consumer_group_name").(string)),
+			KeyName:               utils.String(d.Get("shared_access_key_name").(string)),
+			SharedAccessKey:       utils.String(d.Get("shared_access_key").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("timestamp_property_name"); ok {
+		eventSourceProperties.EventHubEventSourceCreationProperties.TimestampPropertyName = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, environmentId.ResourceGroup, environmentId.Name, name, eventSourceProperties); err != nil {
+		return fmt.Errorf("creating/updating Time Series Insights Event Hub Event Source %q (Environment %q / Resource Group %q): %+v", name, environmentId.Name, environmentId.ResourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, environmentId.ResourceGroup, environmentId.Name, name)
+	if err != nil {
+		return fmt.Errorf("retrieving Time Series Insights Event Hub Event Source %q (Environment %q / Resource Group %q): %+v", name, environmentId.Name, environmentId.ResourceGroup, err)
+	}
+
+	eventSource, ok := resp.Value.AsEventHubEventSourceResource()
+	if !ok {
+		return fmt.Errorf("resource was not an Event Hub Time Series Insights Event Source %q (Environment %q / Resource Group %q)", name, environmentId.Name, environmentId.ResourceGroup)
+	}
+
+	if eventSource.ID == nil {
+		return fmt.Errorf("cannot read Time Series Insights Event Hub Event Source %q (Environment %q / Resource Group %q) ID", name, environmentId.Name, environmentId.ResourceGroup)
+	}
+
+	d.SetId(*eventSource.ID)
+
+	return resourceArmTimeSeriesInsightsEventSourceEventHubRead(d, meta)
+}
+
+func resourceArmTimeSeriesInsightsEventSourceEventHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.EventSourcesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.EventSourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+	if err != nil || resp.Value == nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Time Series Insights Event Hub Event Source %q (Environment %q / Resource Group %q): %+v", id.Name, id.EnvironmentName, id.ResourceGroup, err)
+	}
+
+	eventSource, ok := resp.Value.AsEventHubEventSourceResource()
+	if !ok {
+		return fmt.Errorf("the Time Series Insights Event Source %q (Environment %q / Resource Group %q) is not an Event Hub Event Source - to import an IoT Hub Event Source use `azurerm_time_series_insights_event_source_iothub` instead", id.Name, id.EnvironmentName, id.ResourceGroup)
+	}
+
+	d.Set("name", eventSource.Name)
+	environmentId := parse.EnvironmentId{SubscriptionId: id.SubscriptionId, ResourceGroup: id.ResourceGroup, Name: id.EnvironmentName}
+	d.Set("time_series_insights_environment_id", environmentId.ID())
+	if location := eventSource.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := eventSource.EventHubEventSourceResourceProperties; props != nil {
+		d.Set("event_source_resource_id", props.EventSourceResourceID)
+		d.Set("consumer_group_name", props.ConsumerGroupName)
+		d.Set("shared_access_key_name", props.KeyName)
+		d.Set("timestamp_property_name", props.TimestampPropertyName)
+	}
+
+	return tags.FlattenAndSet(d, eventSource.Tags)
+}
+
+func resourceArmTimeSeriesInsightsEventSourceEventHubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.EventSourcesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.EventSourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Delete(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("deleting Time Series Insights Event Hub Event Source %q (Environment %q / Resource Group %q): %+v", id.Name, id.EnvironmentName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
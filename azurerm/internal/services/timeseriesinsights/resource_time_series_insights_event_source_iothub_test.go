@@ -0,0 +1,217 @@
+package timeseriesinsights_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/timeseriesinsights/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMTimeSeriesInsightsEventSourceIoTHub_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_time_series_insights_event_source_iothub", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMTimeSeriesInsightsEventSourceIoTHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTimeSeriesInsightsEventSourceIoTHub_basicConfig(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTimeSeriesInsightsEventSourceIoTHubExists(data.ResourceName),
+				),
+			},
+			data.ImportStep("shared_access_key"),
+		},
+	})
+}
+
+func TestAccAzureRMTimeSeriesInsightsEventSourceIoTHub_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_time_series_insights_event_source_iothub", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMTimeSeriesInsightsEventSourceIoTHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTimeSeriesInsightsEventSourceIoTHub_basicConfig(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTimeSeriesInsightsEventSourceIoTHubExists(data.ResourceName),
+				),
+			},
+			{
+				// changing the timestamp property should update in-place, not destroy/recreate
+				Config: testAccAzureRMTimeSeriesInsightsEventSourceIoTHub_updateConfig(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTimeSeriesInsightsEventSourceIoTHubExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "timestamp_property_name", "updatedTimestamp"),
+				),
+			},
+			data.ImportStep("shared_access_key"),
+		},
+	})
+}
+
+func testCheckAzureRMTimeSeriesInsightsEventSourceIoTHubExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).TimeSeriesInsights.EventSourcesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Time Series Insights IoT Hub Event Source not found: %s", resourceName)
+		}
+
+		id, err := parse.EventSourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Time Series Insights IoT Hub Event Source %q (Environment %q / Resource Group %q) does not exist", id.Name, id.EnvironmentName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("getting on TimeSeriesInsightsEventSourcesClient: %+v", err)
+		}
+
+		if _, ok := resp.Value.AsIoTHubEventSourceResource(); !ok {
+			return fmt.Errorf("%q (Environment %q / Resource Group %q) is not an IoT Hub Time Series Insights Event Source", id.Name, id.EnvironmentName, id.ResourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMTimeSeriesInsightsEventSourceIoTHubDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).TimeSeriesInsights.EventSourcesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_time_series_insights_event_source_iothub" {
+			continue
+		}
+
+		id, err := parse.EventSourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Time Series Insights IoT Hub Event Source still exists: %q (Environment %q / Resource Group %q)", id.Name, id.EnvironmentName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMTimeSeriesInsightsEventSourceIoTHub_basicConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-tsi-%d"
+  location = "%s"
+}
+
+resource "azurerm_time_series_insights_environment" "test" {
+  name                = "acctestTSIEnv%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "S1_1"
+  data_retention_time = "P30D"
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestiothub%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = 1
+  }
+}
+
+resource "azurerm_iothub_consumer_group" "test" {
+  name                   = "$Default"
+  iothub_name            = azurerm_iothub.test.name
+  eventhub_endpoint_name = "events"
+  resource_group_name    = azurerm_resource_group.test.name
+}
+
+resource "azurerm_time_series_insights_event_source_iothub" "test" {
+  name                                 = "acctestTSIIS%d"
+  time_series_insights_environment_id = azurerm_time_series_insights_environment.test.id
+  event_source_resource_id             = azurerm_iothub.test.id
+  shared_access_key                    = azurerm_iothub.test.shared_access_policy.0.primary_key
+  shared_access_key_name               = azurerm_iothub.test.shared_access_policy.0.key_name
+  consumer_group_name                  = azurerm_iothub_consumer_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func testAccAzureRMTimeSeriesInsightsEventSourceIoTHub_updateConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-tsi-%d"
+  location = "%s"
+}
+
+resource "azurerm_time_series_insights_environment" "test" {
+  name                = "acctestTSIEnv%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "S1_1"
+  data_retention_time = "P30D"
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestiothub%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = 1
+  }
+}
+
+resource "azurerm_iothub_consumer_group" "test" {
+  name                   = "$Default"
+  iothub_name            = azurerm_iothub.test.name
+  eventhub_endpoint_name = "events"
+  resource_group_name    = azurerm_resource_group.test.name
+}
+
+resource "azurerm_time_series_insights_event_source_iothub" "test" {
+  name                                 = "acctestTSIIS%d"
+  time_series_insights_environment_id = azurerm_time_series_insights_environment.test.id
+  event_source_resource_id             = azurerm_iothub.test.id
+  shared_access_key                    = azurerm_iothub.test.shared_access_policy.0.primary_key
+  shared_access_key_name               = azurerm_iothub.test.shared_access_policy.0.key_name
+  consumer_group_name                  = azurerm_iothub_consumer_group.test.name
+  timestamp_property_name              = "updatedTimestamp"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
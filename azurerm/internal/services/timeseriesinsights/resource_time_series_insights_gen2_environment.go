@@ -0,0 +1,418 @@
+package timeseriesinsights
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/timeseriesinsights/mgmt/2018-08-15-preview/timeseriesinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	azValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/timeseriesinsights/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmTimeSeriesInsightsGen2Environment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmTimeSeriesInsightsGen2EnvironmentCreateUpdate,
+		Read:   resourceArmTimeSeriesInsightsGen2EnvironmentRead,
+		Update: resourceArmTimeSeriesInsightsGen2EnvironmentCreateUpdate,
+		Delete: resourceArmTimeSeriesInsightsGen2EnvironmentDelete,
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.TimeSeriesInsightsEnvironmentID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[-\w\._\(\)]+$`),
+					"Time Series Insights Environment name must not be empty and may contain only word characters, hyphens, periods and parentheses.",
+				),
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"L1_1",
+					"L1_2",
+					"L1_3",
+					"L1_4",
+					"L1_5",
+					"L1_6",
+					"L1_7",
+					"L1_8",
+					"L1_9",
+					"L1_10",
+				}, false),
+			},
+
+			"storage_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"storage_account_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						// the management key is write-only, the API never returns it back
+						"management_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"time_series_id_properties": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(timeseriesinsights.String),
+								string(timeseriesinsights.Double),
+								string(timeseriesinsights.Bool),
+								string(timeseriesinsights.DateTime),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"warm_store_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_retention": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateTimeSeriesInsightsGen2WarmStoreDataRetention,
+						},
+					},
+				},
+			},
+
+			"tags": tags.ForceNewSchema(),
+		},
+	}
+}
+
+func resourceArmTimeSeriesInsightsGen2EnvironmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.EnvironmentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	t := d.Get("tags").(map[string]interface{})
+	sku, err := expandEnvironmentSkuName(d.Get("sku_name").(string))
+	if err != nil {
+		return fmt.Errorf("expanding sku: %+v", err)
+	}
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Time Series Insights Gen2 Environment %q (Resource Group %q): %s", name, resourceGroup, err)
+			}
+		}
+
+		if existing.Value != nil {
+			environment, ok := existing.Value.AsLongTermEnvironmentResource()
+			if !ok {
+				return fmt.Errorf("exisiting resource was not a Long-Term (Gen2) Time Series Insights Environment %q (Resource Group %q)", name, resourceGroup)
+			}
+
+			if environment.ID != nil && *environment.ID != "" {
+				return tf.ImportAsExistsError("azurerm_time_series_insights_gen2_environment", *environment.ID)
+			}
+		}
+	}
+
+	props := &timeseriesinsights.LongTermEnvironmentCreationProperties{
+		StorageConfiguration:   expandTimeSeriesInsightsGen2EnvironmentStorageConfiguration(d.Get("storage_configuration").([]interface{})),
+		TimeSeriesIDProperties: expandTimeSeriesInsightsGen2EnvironmentIDProperties(d.Get("time_series_id_properties").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("warm_store_configuration"); ok {
+		props.WarmStoreConfiguration = expandTimeSeriesInsightsGen2EnvironmentWarmStoreConfiguration(v.([]interface{}))
+	}
+
+	environment := timeseriesinsights.LongTermEnvironmentCreateOrUpdateParameters{
+		Location:                              &location,
+		Tags:                                  tags.Expand(t),
+		Sku:                                   sku,
+		LongTermEnvironmentCreationProperties: props,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, environment)
+	if err != nil {
+		return fmt.Errorf("creating/updating Time Series Insights Gen2 Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for completion of Time Series Insights Gen2 Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Time Series Insights Gen2 Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resource, ok := resp.Value.AsLongTermEnvironmentResource()
+	if !ok {
+		return fmt.Errorf("resource was not a Long-Term (Gen2) Time Series Insights Environment %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	if resource.ID == nil {
+		return fmt.Errorf("cannot read Time Series Insights Gen2 Environment %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*resource.ID)
+
+	return resourceArmTimeSeriesInsightsGen2EnvironmentRead(d, meta)
+}
+
+func resourceArmTimeSeriesInsightsGen2EnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.EnvironmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.TimeSeriesInsightsEnvironmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil || resp.Value == nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Time Series Insights Gen2 Environment %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	environment, ok := resp.Value.AsLongTermEnvironmentResource()
+	if !ok {
+		return fmt.Errorf("exisiting resource was not a Long-Term (Gen2) Time Series Insights Environment %q (Resource Group %q)", id.Name, id.ResourceGroup)
+	}
+
+	d.Set("name", environment.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("sku_name", flattenEnvironmentSkuName(environment.Sku))
+	if location := environment.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := environment.LongTermEnvironmentResourceProperties; props != nil {
+		if err := d.Set("storage_configuration", flattenTimeSeriesInsightsGen2EnvironmentStorageConfiguration(props.StorageConfiguration, d)); err != nil {
+			return fmt.Errorf("setting `storage_configuration`: %+v", err)
+		}
+
+		if err := d.Set("time_series_id_properties", flattenTimeSeriesInsightsGen2EnvironmentIDProperties(props.TimeSeriesIDProperties)); err != nil {
+			return fmt.Errorf("setting `time_series_id_properties`: %+v", err)
+		}
+
+		if err := d.Set("warm_store_configuration", flattenTimeSeriesInsightsGen2EnvironmentWarmStoreConfiguration(props.WarmStoreConfiguration)); err != nil {
+			return fmt.Errorf("setting `warm_store_configuration`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, environment.Tags)
+}
+
+func resourceArmTimeSeriesInsightsGen2EnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.EnvironmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.TimeSeriesInsightsEnvironmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("deleting Time Series Insights Gen2 Environment %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandTimeSeriesInsightsGen2EnvironmentStorageConfiguration(input []interface{}) *timeseriesinsights.LongTermStorageConfigurationInput {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &timeseriesinsights.LongTermStorageConfigurationInput{
+		AccountName:   utils.String(v["storage_account_name"].(string)),
+		ManagementKey: utils.String(v["management_key"].(string)),
+	}
+}
+
+func flattenTimeSeriesInsightsGen2EnvironmentStorageConfiguration(input *timeseriesinsights.LongTermStorageConfigurationOutput, d *schema.ResourceData) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	accountName := ""
+	if input.AccountName != nil {
+		accountName = *input.AccountName
+	}
+
+	// the management key is never returned by the API, so we persist the value that's already in state/config
+	managementKey := d.Get("storage_configuration.0.management_key").(string)
+
+	return []interface{}{
+		map[string]interface{}{
+			"storage_account_name": accountName,
+			"management_key":       managementKey,
+		},
+	}
+}
+
+func expandTimeSeriesInsightsGen2EnvironmentIDProperties(input []interface{}) *[]timeseriesinsights.TimeSeriesIDProperty {
+	results := make([]timeseriesinsights.TimeSeriesIDProperty, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, timeseriesinsights.TimeSeriesIDProperty{
+			Name: utils.String(v["name"].(string)),
+			Type: timeseriesinsights.PropertyType(v["type"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenTimeSeriesInsightsGen2EnvironmentIDProperties(input *[]timeseriesinsights.TimeSeriesIDProperty) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		results = append(results, map[string]interface{}{
+			"name": name,
+			"type": string(item.Type),
+		})
+	}
+
+	return results
+}
+
+func expandTimeSeriesInsightsGen2EnvironmentWarmStoreConfiguration(input []interface{}) *timeseriesinsights.WarmStoreConfigurationProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &timeseriesinsights.WarmStoreConfigurationProperties{
+		DataRetention: utils.String(v["data_retention"].(string)),
+	}
+}
+
+func flattenTimeSeriesInsightsGen2EnvironmentWarmStoreConfiguration(input *timeseriesinsights.WarmStoreConfigurationProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	dataRetention := ""
+	if input.DataRetention != nil {
+		dataRetention = *input.DataRetention
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"data_retention": dataRetention,
+		},
+	}
+}
+
+var warmStoreDataRetentionRegex = regexp.MustCompile(`^P(\d+)D$`)
+
+func validateTimeSeriesInsightsGen2WarmStoreDataRetention(i interface{}, k string) (warnings []string, errors []error) {
+	if warnings, errors = azValidate.ISO8601Duration(i, k); len(errors) > 0 {
+		return warnings, errors
+	}
+
+	v := i.(string)
+	matches := warmStoreDataRetentionRegex.FindStringSubmatch(v)
+	if matches == nil {
+		errors = append(errors, fmt.Errorf("%q must be an ISO-8601 duration expressed in whole days (e.g. `P7D`), got %q", k, v))
+		return warnings, errors
+	}
+
+	days, err := strconv.Atoi(matches[1])
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q could not be parsed as a number of days: %+v", k, err))
+		return warnings, errors
+	}
+
+	if days < 7 || days > 31 {
+		errors = append(errors, fmt.Errorf("%q must be between `P7D` and `P31D` (7 to 31 days), got %q", k, v))
+	}
+
+	return warnings, errors
+}
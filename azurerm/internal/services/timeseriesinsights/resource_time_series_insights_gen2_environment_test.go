@@ -0,0 +1,193 @@
+package timeseriesinsights_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/timeseriesinsights/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMTimeSeriesInsightsGen2Environment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_time_series_insights_gen2_environment", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMTimeSeriesInsightsGen2EnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTimeSeriesInsightsGen2Environment_basicConfig(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTimeSeriesInsightsGen2EnvironmentExists(data.ResourceName),
+				),
+			},
+			data.ImportStep("storage_configuration.0.management_key"),
+		},
+	})
+}
+
+func TestAccAzureRMTimeSeriesInsightsGen2Environment_warmStore(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_time_series_insights_gen2_environment", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMTimeSeriesInsightsGen2EnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTimeSeriesInsightsGen2Environment_warmStoreConfig(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTimeSeriesInsightsGen2EnvironmentExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "warm_store_configuration.0.data_retention", "P7D"),
+				),
+			},
+			data.ImportStep("storage_configuration.0.management_key"),
+		},
+	})
+}
+
+func testCheckAzureRMTimeSeriesInsightsGen2EnvironmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).TimeSeriesInsights.EnvironmentsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Time Series Insights Gen2 Environment not found: %s", resourceName)
+		}
+
+		id, err := parse.TimeSeriesInsightsEnvironmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Time Series Insights Gen2 Environment %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("getting on TimeSeriesInsightsEnvironmentsClient: %+v", err)
+		}
+
+		if _, ok := resp.Value.AsLongTermEnvironmentResource(); !ok {
+			return fmt.Errorf("%q (Resource Group %q) is not a Long-Term (Gen2) Time Series Insights Environment", id.Name, id.ResourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMTimeSeriesInsightsGen2EnvironmentDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).TimeSeriesInsights.EnvironmentsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_time_series_insights_gen2_environment" {
+			continue
+		}
+
+		id, err := parse.TimeSeriesInsightsEnvironmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Time Series Insights Gen2 Environment still exists: %q (Resource Group %q)", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMTimeSeriesInsightsGen2Environment_basicConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-tsi-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsatsi%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_time_series_insights_gen2_environment" "test" {
+  name                = "acctestTSIGen2Env%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "L1_1"
+
+  storage_configuration {
+    storage_account_name = azurerm_storage_account.test.name
+    management_key       = azurerm_storage_account.test.primary_access_key
+  }
+
+  time_series_id_properties {
+    name = "id"
+    type = "String"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func testAccAzureRMTimeSeriesInsightsGen2Environment_warmStoreConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-tsi-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsatsi%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_time_series_insights_gen2_environment" "test" {
+  name                = "acctestTSIGen2Env%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "L1_1"
+
+  storage_configuration {
+    storage_account_name = azurerm_storage_account.test.name
+    management_key       = azurerm_storage_account.test.primary_access_key
+  }
+
+  time_series_id_properties {
+    name = "id"
+    type = "String"
+  }
+
+  warm_store_configuration {
+    data_retention = "P7D"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
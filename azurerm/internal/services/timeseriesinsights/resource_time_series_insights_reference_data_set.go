@@ -0,0 +1,239 @@
+package timeseriesinsights
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/timeseriesinsights/mgmt/2018-08-15-preview/timeseriesinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/timeseriesinsights/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmTimeSeriesInsightsReferenceDataSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmTimeSeriesInsightsReferenceDataSetCreateUpdate,
+		Read:   resourceArmTimeSeriesInsightsReferenceDataSetRead,
+		Update: resourceArmTimeSeriesInsightsReferenceDataSetCreateUpdate,
+		Delete: resourceArmTimeSeriesInsightsReferenceDataSetDelete,
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ReferenceDataSetID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[-\w\._\(\)]+$`),
+					"Time Series Insights Reference Data Set name must not be empty and may contain only word characters, hyphens, periods and parentheses.",
+				),
+			},
+
+			"time_series_insights_environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"key_properties": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(timeseriesinsights.String),
+								string(timeseriesinsights.Double),
+								string(timeseriesinsights.Bool),
+								string(timeseriesinsights.DateTime),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"data_string_comparison_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(timeseriesinsights.Ordinal),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(timeseriesinsights.Ordinal),
+					string(timeseriesinsights.OrdinalIgnoreCase),
+				}, false),
+			},
+
+			"tags": tags.ForceNewSchema(),
+		},
+	}
+}
+
+func resourceArmTimeSeriesInsightsReferenceDataSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.ReferenceDataSetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	environmentId, err := parse.TimeSeriesInsightsEnvironmentID(d.Get("time_series_insights_environment_id").(string))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	t := d.Get("tags").(map[string]interface{})
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, environmentId.ResourceGroup, environmentId.Name, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Time Series Insights Reference Data Set %q (Environment %q / Resource Group %q): %s", name, environmentId.Name, environmentId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_time_series_insights_reference_data_set", *existing.ID)
+		}
+	}
+
+	dataSet := timeseriesinsights.ReferenceDataSetCreateOrUpdateParameters{
+		Tags: tags.Expand(t),
+		ReferenceDataSetCreationProperties: &timeseriesinsights.ReferenceDataSetCreationProperties{
+			KeyProperties:                expandTimeSeriesInsightsReferenceDataSetKeyProperties(d.Get("key_properties").([]interface{})),
+			DataStringComparisonBehavior: timeseriesinsights.DataStringComparisonBehavior(d.Get("data_string_comparison_behavior").(string)),
+		},
+	}
+
+	resp, err := client.CreateOrUpdate(ctx, environmentId.ResourceGroup, environmentId.Name, name, dataSet)
+	if err != nil {
+		return fmt.Errorf("creating/updating Time Series Insights Reference Data Set %q (Environment %q / Resource Group %q): %+v", name, environmentId.Name, environmentId.ResourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("cannot read Time Series Insights Reference Data Set %q (Environment %q / Resource Group %q) ID", name, environmentId.Name, environmentId.ResourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmTimeSeriesInsightsReferenceDataSetRead(d, meta)
+}
+
+func resourceArmTimeSeriesInsightsReferenceDataSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.ReferenceDataSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ReferenceDataSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Time Series Insights Reference Data Set %q (Environment %q / Resource Group %q): %+v", id.Name, id.EnvironmentName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	environmentId := parse.EnvironmentId{SubscriptionId: id.SubscriptionId, ResourceGroup: id.ResourceGroup, Name: id.EnvironmentName}
+	d.Set("time_series_insights_environment_id", environmentId.ID())
+
+	if props := resp.ReferenceDataSetResourceProperties; props != nil {
+		if err := d.Set("key_properties", flattenTimeSeriesInsightsReferenceDataSetKeyProperties(props.KeyProperties)); err != nil {
+			return fmt.Errorf("setting `key_properties`: %+v", err)
+		}
+
+		d.Set("data_string_comparison_behavior", string(props.DataStringComparisonBehavior))
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmTimeSeriesInsightsReferenceDataSetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TimeSeriesInsights.ReferenceDataSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ReferenceDataSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Delete(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("deleting Time Series Insights Reference Data Set %q (Environment %q / Resource Group %q): %+v", id.Name, id.EnvironmentName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandTimeSeriesInsightsReferenceDataSetKeyProperties(input []interface{}) *[]timeseriesinsights.ReferenceDataSetKeyProperty {
+	results := make([]timeseriesinsights.ReferenceDataSetKeyProperty, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, timeseriesinsights.ReferenceDataSetKeyProperty{
+			KeyPropertyName: utils.String(v["name"].(string)),
+			KeyPropertyType: timeseriesinsights.PropertyType(v["type"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenTimeSeriesInsightsReferenceDataSetKeyProperties(input *[]timeseriesinsights.ReferenceDataSetKeyProperty) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.KeyPropertyName != nil {
+			name = *item.KeyPropertyName
+		}
+
+		results = append(results, map[string]interface{}{
+			"name": name,
+			"type": string(item.KeyPropertyType),
+		})
+	}
+
+	return results
+}
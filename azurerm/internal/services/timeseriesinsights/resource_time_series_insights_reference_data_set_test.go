@@ -0,0 +1,120 @@
+package timeseriesinsights_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/timeseriesinsights/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMTimeSeriesInsightsReferenceDataSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_time_series_insights_reference_data_set", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMTimeSeriesInsightsReferenceDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTimeSeriesInsightsReferenceDataSet_basicConfig(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTimeSeriesInsightsReferenceDataSetExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAzureRMTimeSeriesInsightsReferenceDataSetExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).TimeSeriesInsights.ReferenceDataSetsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Time Series Insights Reference Data Set not found: %s", resourceName)
+		}
+
+		id, err := parse.ReferenceDataSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Time Series Insights Reference Data Set %q (Environment %q / Resource Group %q) does not exist", id.Name, id.EnvironmentName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("getting on TimeSeriesInsightsReferenceDataSetsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMTimeSeriesInsightsReferenceDataSetDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).TimeSeriesInsights.ReferenceDataSetsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_time_series_insights_reference_data_set" {
+			continue
+		}
+
+		id, err := parse.ReferenceDataSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Time Series Insights Reference Data Set still exists: %q (Environment %q / Resource Group %q)", id.Name, id.EnvironmentName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMTimeSeriesInsightsReferenceDataSet_basicConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-tsi-%d"
+  location = "%s"
+}
+
+resource "azurerm_time_series_insights_environment" "test" {
+  name                = "acctestTSIEnv%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "S1_1"
+  data_retention_time = "P30D"
+}
+
+resource "azurerm_time_series_insights_reference_data_set" "test" {
+  name                                 = "acctestTSIRDS%d"
+  time_series_insights_environment_id = azurerm_time_series_insights_environment.test.id
+
+  key_properties {
+    name = "key1"
+    type = "String"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}